@@ -0,0 +1,61 @@
+// Package alerts implements price threshold subscriptions: a chat asks to
+// be told when a symbol crosses above or below a price, the alert is kept
+// on disk so it survives a restart, and a Scheduler polls prices in the
+// background and notifies the chat when a threshold is crossed.
+package alerts
+
+import "fmt"
+
+// Direction is which side of Threshold triggers an Alert.
+type Direction string
+
+const (
+	Above Direction = "above"
+	Below Direction = "below"
+)
+
+// Alert is a single chat's subscription to a symbol crossing Threshold.
+type Alert struct {
+	ID        uint64
+	ChatID    int64
+	Exchange  string
+	Symbol    string
+	Quote     string
+	Direction Direction
+	Threshold float64
+
+	// Armed is false once the alert has fired, until the price crosses back
+	// past Threshold in the opposite direction, so we don't re-notify the
+	// chat on every poll while the price stays crossed.
+	Armed bool
+}
+
+func (alert Alert) String() string {
+	return fmt.Sprintf("#%d: %s/%s %s %s %.8f", alert.ID, alert.Symbol, alert.Quote, alert.Exchange, alert.Direction, alert.Threshold)
+}
+
+// Crossed reports whether price has crossed the alert's threshold in its
+// armed direction.
+func (alert Alert) Crossed(price float64) bool {
+	if alert.Direction == Above {
+		return price >= alert.Threshold
+	}
+	return price <= alert.Threshold
+}
+
+// Store persists Alerts so they survive a restart.
+type Store interface {
+	// Create saves alert, assigning it an ID.
+	Create(alert Alert) (Alert, error)
+	// List returns every alert registered for chatID.
+	List(chatID int64) ([]Alert, error)
+	// All returns every alert across every chat, for the Scheduler to poll.
+	All() ([]Alert, error)
+	// Delete removes chatID's alert with the given id. It returns an error
+	// if no such alert exists.
+	Delete(chatID int64, id uint64) error
+	// SetArmed updates whether an alert is armed to fire.
+	SetArmed(id uint64, armed bool) error
+	// Close releases the store's underlying resources.
+	Close() error
+}
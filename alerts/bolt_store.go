@@ -0,0 +1,136 @@
+package alerts
+
+import (
+	"encoding/json"
+	"fmt"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var alertsBucket = []byte("alerts")
+
+// BoltStore is a Store backed by a BoltDB file on disk.
+type BoltStore struct {
+	db *bolt.DB
+}
+
+// NewBoltStore opens (creating if necessary) a BoltDB file at path to use
+// as an alert Store.
+func NewBoltStore(path string) (*BoltStore, error) {
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(alertsBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &BoltStore{db: db}, nil
+}
+
+func (store *BoltStore) Create(alert Alert) (Alert, error) {
+	err := store.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(alertsBucket)
+		id, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+		alert.ID = id
+		alert.Armed = true
+
+		data, err := json.Marshal(alert)
+		if err != nil {
+			return err
+		}
+		return bucket.Put(idKey(id), data)
+	})
+	return alert, err
+}
+
+func (store *BoltStore) List(chatID int64) ([]Alert, error) {
+	all, err := store.All()
+	if err != nil {
+		return nil, err
+	}
+
+	var alerts []Alert
+	for _, alert := range all {
+		if alert.ChatID == chatID {
+			alerts = append(alerts, alert)
+		}
+	}
+	return alerts, nil
+}
+
+func (store *BoltStore) All() ([]Alert, error) {
+	var alerts []Alert
+	err := store.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(alertsBucket)
+		return bucket.ForEach(func(_, data []byte) error {
+			var alert Alert
+			if err := json.Unmarshal(data, &alert); err != nil {
+				return err
+			}
+			alerts = append(alerts, alert)
+			return nil
+		})
+	})
+	return alerts, err
+}
+
+func (store *BoltStore) Delete(chatID int64, id uint64) error {
+	return store.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(alertsBucket)
+		key := idKey(id)
+		data := bucket.Get(key)
+		if data == nil {
+			return fmt.Errorf("no alert #%d", id)
+		}
+
+		var alert Alert
+		if err := json.Unmarshal(data, &alert); err != nil {
+			return err
+		}
+		if alert.ChatID != chatID {
+			return fmt.Errorf("no alert #%d", id)
+		}
+		return bucket.Delete(key)
+	})
+}
+
+func (store *BoltStore) SetArmed(id uint64, armed bool) error {
+	return store.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(alertsBucket)
+		key := idKey(id)
+		data := bucket.Get(key)
+		if data == nil {
+			return fmt.Errorf("no alert #%d", id)
+		}
+
+		var alert Alert
+		if err := json.Unmarshal(data, &alert); err != nil {
+			return err
+		}
+		alert.Armed = armed
+
+		updated, err := json.Marshal(alert)
+		if err != nil {
+			return err
+		}
+		return bucket.Put(key, updated)
+	})
+}
+
+func (store *BoltStore) Close() error {
+	return store.db.Close()
+}
+
+func idKey(id uint64) []byte {
+	return []byte(fmt.Sprintf("%020d", id))
+}
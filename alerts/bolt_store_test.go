@@ -0,0 +1,123 @@
+package alerts
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func newTestBoltStore(t *testing.T) *BoltStore {
+	t.Helper()
+	store, err := NewBoltStore(filepath.Join(t.TempDir(), "alerts.db"))
+	if err != nil {
+		t.Fatalf("could not open test store: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+func TestBoltStoreCreateAssignsIDAndArms(t *testing.T) {
+	store := newTestBoltStore(t)
+
+	created, err := store.Create(Alert{ChatID: 1, Symbol: "BTC", Quote: "USD", Exchange: "cexio", Direction: Above, Threshold: 100})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.ID == 0 {
+		t.Error("expected a non-zero ID to be assigned")
+	}
+	if !created.Armed {
+		t.Error("expected a newly created alert to be armed")
+	}
+}
+
+func TestBoltStoreListOnlyReturnsChatsOwnAlerts(t *testing.T) {
+	store := newTestBoltStore(t)
+
+	if _, err := store.Create(Alert{ChatID: 1, Symbol: "BTC", Quote: "USD", Exchange: "cexio", Direction: Above, Threshold: 100}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := store.Create(Alert{ChatID: 2, Symbol: "ETH", Quote: "USD", Exchange: "cexio", Direction: Below, Threshold: 50}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	chatOneAlerts, err := store.List(1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(chatOneAlerts) != 1 || chatOneAlerts[0].Symbol != "BTC" {
+		t.Errorf("expected chat 1 to have just its BTC alert, got %v", chatOneAlerts)
+	}
+}
+
+func TestBoltStoreAllReturnsEveryChatsAlerts(t *testing.T) {
+	store := newTestBoltStore(t)
+
+	if _, err := store.Create(Alert{ChatID: 1, Symbol: "BTC", Quote: "USD", Exchange: "cexio", Direction: Above, Threshold: 100}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := store.Create(Alert{ChatID: 2, Symbol: "ETH", Quote: "USD", Exchange: "cexio", Direction: Below, Threshold: 50}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	all, err := store.All()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(all) != 2 {
+		t.Errorf("expected 2 alerts, got %d", len(all))
+	}
+}
+
+func TestBoltStoreDeleteRequiresMatchingChat(t *testing.T) {
+	store := newTestBoltStore(t)
+
+	created, err := store.Create(Alert{ChatID: 1, Symbol: "BTC", Quote: "USD", Exchange: "cexio", Direction: Above, Threshold: 100})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := store.Delete(2, created.ID); err == nil {
+		t.Error("expected deleting another chat's alert to fail")
+	}
+
+	if err := store.Delete(1, created.ID); err != nil {
+		t.Errorf("unexpected error deleting own alert: %v", err)
+	}
+
+	remaining, err := store.All()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(remaining) != 0 {
+		t.Errorf("expected alert to be gone, got %v", remaining)
+	}
+}
+
+func TestBoltStoreSetArmed(t *testing.T) {
+	store := newTestBoltStore(t)
+
+	created, err := store.Create(Alert{ChatID: 1, Symbol: "BTC", Quote: "USD", Exchange: "cexio", Direction: Above, Threshold: 100})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := store.SetArmed(created.ID, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	all, err := store.All()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if all[0].Armed {
+		t.Error("expected alert to be disarmed")
+	}
+}
+
+func TestBoltStoreSetArmedUnknownID(t *testing.T) {
+	store := newTestBoltStore(t)
+
+	if err := store.SetArmed(999, false); err == nil {
+		t.Error("expected an error setting armed on an unknown alert")
+	}
+}
@@ -0,0 +1,86 @@
+package alerts
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/joncooperworks/cryptopricesbot/exchanges"
+)
+
+// Notifier delivers an alert-fired message to a chat. The bot package
+// implements this over its tgbotapi connection so this package doesn't need
+// to know anything about Telegram.
+type Notifier interface {
+	Notify(chatID int64, message string) error
+}
+
+// Scheduler polls every registered Alert on an interval and notifies its
+// chat when the alert's threshold is crossed.
+type Scheduler struct {
+	Store    Store
+	Notifier Notifier
+	Interval time.Duration
+}
+
+// NewScheduler builds a Scheduler that polls store's alerts every interval,
+// delivering fired alerts through notifier.
+func NewScheduler(store Store, notifier Notifier, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		Store:    store,
+		Notifier: notifier,
+		Interval: interval,
+	}
+}
+
+// Run polls forever, blocking the calling goroutine. Callers should run it
+// in its own goroutine.
+func (scheduler *Scheduler) Run() {
+	ticker := time.NewTicker(scheduler.Interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		scheduler.poll()
+	}
+}
+
+func (scheduler *Scheduler) poll() {
+	alertList, err := scheduler.Store.All()
+	if err != nil {
+		log.Println("Could not list alerts:", err)
+		return
+	}
+
+	for _, alert := range alertList {
+		source, found := exchanges.Registry[alert.Exchange]
+		if !found {
+			continue
+		}
+
+		quote, err := source.Ticker(exchanges.Pair{First: alert.Symbol, Second: alert.Quote})
+		if err != nil {
+			log.Printf("Could not poll alert %s: %v", alert, err)
+			continue
+		}
+
+		crossed := alert.Crossed(quote.Price)
+		if crossed && alert.Armed {
+			message := fmt.Sprintf(
+				"%s/%s on %s is %s %.8f: now %.8f",
+				alert.Symbol, alert.Quote, alert.Exchange, alert.Direction, alert.Threshold, quote.Price,
+			)
+			if err := scheduler.Notifier.Notify(alert.ChatID, message); err != nil {
+				log.Println("Could not send alert notification:", err)
+				continue
+			}
+			if err := scheduler.Store.SetArmed(alert.ID, false); err != nil {
+				log.Println("Could not disarm alert:", err)
+			}
+		} else if !crossed && !alert.Armed {
+			// Price crossed back past the threshold: re-arm so the alert
+			// can fire again next time it's crossed.
+			if err := scheduler.Store.SetArmed(alert.ID, true); err != nil {
+				log.Println("Could not re-arm alert:", err)
+			}
+		}
+	}
+}
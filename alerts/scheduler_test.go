@@ -0,0 +1,143 @@
+package alerts
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/joncooperworks/cryptopricesbot/exchanges"
+)
+
+// fakeStore is an in-memory Store for exercising the Scheduler without Bolt.
+type fakeStore struct {
+	mu     sync.Mutex
+	alerts map[uint64]Alert
+}
+
+func newFakeStore(alert Alert) *fakeStore {
+	return &fakeStore{alerts: map[uint64]Alert{alert.ID: alert}}
+}
+
+func (store *fakeStore) Create(alert Alert) (Alert, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	store.alerts[alert.ID] = alert
+	return alert, nil
+}
+
+func (store *fakeStore) List(chatID int64) ([]Alert, error) {
+	var alerts []Alert
+	for _, alert := range store.alerts {
+		if alert.ChatID == chatID {
+			alerts = append(alerts, alert)
+		}
+	}
+	return alerts, nil
+}
+
+func (store *fakeStore) All() ([]Alert, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	var alerts []Alert
+	for _, alert := range store.alerts {
+		alerts = append(alerts, alert)
+	}
+	return alerts, nil
+}
+
+func (store *fakeStore) Delete(chatID int64, id uint64) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	delete(store.alerts, id)
+	return nil
+}
+
+func (store *fakeStore) SetArmed(id uint64, armed bool) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	alert := store.alerts[id]
+	alert.Armed = armed
+	store.alerts[id] = alert
+	return nil
+}
+
+func (store *fakeStore) Close() error { return nil }
+
+// fakeNotifier records every notification it's sent.
+type fakeNotifier struct {
+	mu   sync.Mutex
+	sent int
+}
+
+func (notifier *fakeNotifier) Notify(chatID int64, message string) error {
+	notifier.mu.Lock()
+	defer notifier.mu.Unlock()
+	notifier.sent++
+	return nil
+}
+
+func (notifier *fakeNotifier) count() int {
+	notifier.mu.Lock()
+	defer notifier.mu.Unlock()
+	return notifier.sent
+}
+
+// fakePriceSource reports whatever price is currently set, for driving the
+// Scheduler through specific crossings.
+type fakePriceSource struct {
+	price float64
+}
+
+func (source *fakePriceSource) Ticker(pair exchanges.Pair) (*exchanges.Quote, error) {
+	return &exchanges.Quote{Pair: pair, Price: source.price}, nil
+}
+
+func TestSchedulerDoesNotRefireUntilPriceCrossesBack(t *testing.T) {
+	const testExchange = "scheduler-test-exchange"
+	source := &fakePriceSource{price: 90}
+	exchanges.Register(testExchange, source)
+
+	alert := Alert{ID: 1, ChatID: 1, Exchange: testExchange, Symbol: "BTC", Quote: "USD", Direction: Above, Threshold: 100, Armed: true}
+	store := newFakeStore(alert)
+	notifier := &fakeNotifier{}
+	scheduler := NewScheduler(store, notifier, 0)
+
+	// Below threshold: no notification.
+	scheduler.poll()
+	if notifier.count() != 0 {
+		t.Fatalf("expected no notification below threshold, got %d", notifier.count())
+	}
+
+	// Crosses above threshold: fires once and disarms.
+	source.price = 110
+	scheduler.poll()
+	if notifier.count() != 1 {
+		t.Fatalf("expected 1 notification after crossing above, got %d", notifier.count())
+	}
+
+	// Stays crossed: must not refire.
+	scheduler.poll()
+	if notifier.count() != 1 {
+		t.Fatalf("expected no refire while still crossed, got %d", notifier.count())
+	}
+
+	// Crosses back below: re-arms, still no new notification.
+	source.price = 90
+	scheduler.poll()
+	if notifier.count() != 1 {
+		t.Fatalf("expected no notification on re-arming crossback, got %d", notifier.count())
+	}
+	alerts, err := store.All()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !alerts[0].Armed {
+		t.Fatal("expected alert to be re-armed after crossing back")
+	}
+
+	// Crosses above again: fires a second time now that it's re-armed.
+	source.price = 110
+	scheduler.poll()
+	if notifier.count() != 2 {
+		t.Fatalf("expected a second notification after re-crossing, got %d", notifier.count())
+	}
+}
@@ -1,8 +1,7 @@
 package main
 
 import (
-	"encoding/json"
-	"errors"
+	"context"
 	"fmt"
 	"log"
 	"math"
@@ -11,18 +10,16 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"time"
 
-	"github.com/joncooperworks/jsonjse"
+	"github.com/joncooperworks/cryptopricesbot/alerts"
+	"github.com/joncooperworks/cryptopricesbot/chain"
+	"github.com/joncooperworks/cryptopricesbot/charts"
+	"github.com/joncooperworks/cryptopricesbot/exchanges"
+	"github.com/joncooperworks/cryptopricesbot/router"
 	"gopkg.in/telegram-bot-api.v4"
 )
 
-/* Web Services config */
-const (
-	CEX_IO_PRICE_API_ENDPOINT = "https://cex.io/api/ticker/%s/%s"
-	USERNAME_SEPARATOR        = "@"
-	BOT_NAME                  = USERNAME_SEPARATOR + "coincap_prices_bot"
-)
-
 /* Commands */
 const (
 	START_COMMAND                 = "/start"
@@ -32,21 +29,64 @@ const (
 	ALTERNATIVE_JSE_QUOTE_COMMAND = "/wagwaanfi"
 	YET_ANOTHER_JSE_COMMAND       = "/jse"
 	CEX_IO_COMMAND                = "/cexprice"
+	PRICE_COMMAND                 = "/price"
+	ALERT_COMMAND                 = "/alert"
+	ALERTS_COMMAND                = "/alerts"
+	UNALERT_COMMAND               = "/unalert"
+	TX_COMMAND                    = "/tx"
+	CHART_COMMAND                 = "/chart"
+)
+
+// Exchange /chart fetches candles from. Binance is the only exchange.CandleSource
+// we have for now.
+const CHART_EXCHANGE = "binance"
+
+// Default candle interval used by /chart when none is given, and how many
+// bars it requests.
+const (
+	DEFAULT_CHART_INTERVAL = "1d"
+	CHART_CANDLE_LIMIT     = 90
 )
 
-/* Controller routing table */
+/* How long a rendered chart is cached before it's re-fetched and re-rendered */
+const CHART_CACHE_TTL = 5 * time.Minute
+
+// Esplora-style block explorer API used by /tx. Point CHAIN_API_URL at your
+// own Esplora instance to use something other than blockstream.info.
+const DEFAULT_CHAIN_API_URL = "https://blockstream.info/api"
+
+/* Default exchange used by /price and /alert when none is given */
+const DEFAULT_EXCHANGE = "cexio"
+
+/* Default quote currency used by /alert when none is given */
+const DEFAULT_ALERT_QUOTE = "USD"
+
+/* How often the alert Scheduler polls subscribed prices */
+const ALERT_POLL_INTERVAL = 1 * time.Minute
+
+/* Where alerts are persisted on disk */
+const ALERTS_DB_PATH = "alerts.db"
+
+/* How often a single chat may issue a command */
+const COMMAND_RATE_LIMIT_INTERVAL = 500 * time.Millisecond
+
+// cmdRouter dispatches incoming messages to Handlers. It's built once in
+// main by setupRouter.
+var cmdRouter *router.Router
+
+// alertStore persists /alert subscriptions; alertScheduler polls them in the
+// background. Both are wired up in main.
 var (
-	controllers = map[string]Controller{
-		START_COMMAND:                 StartCommand,
-		HELP_COMMAND:                  HelpCommand,
-		SOURCE_COMMAND:                SourceCommand,
-		JSE_QUOTE_COMMAND:             JseQuoteCommand,
-		ALTERNATIVE_JSE_QUOTE_COMMAND: JseQuoteCommand,
-		YET_ANOTHER_JSE_COMMAND:       JseQuoteCommand,
-		CEX_IO_COMMAND:                CexPriceCommand,
-	}
+	alertStore     alerts.Store
+	alertScheduler *alerts.Scheduler
 )
 
+// chainAPI backs /tx. It's wired up in main.
+var chainAPI *chain.API
+
+// chartCache backs /chart. It's wired up in main.
+var chartCache *charts.Cache
+
 /* Fiat currencies returned in coinmarketcap.com responses */
 var (
 	FIAT_CURRENCIES = map[string]string{
@@ -82,193 +122,271 @@ const (
 	HELP_MESSAGE = "Use me to get prices from the Jamaica Stock Exchange.\n" +
 		"Just send me the symbol. For example: NCBFG.\n" +
 		"I can also tell you wah gwaan fi stocks on the Jamaica Stock Exchange.\n" +
-		"For example, /wahgwaanfi NCBFG"
+		"For example, /wahgwaanfi NCBFG\n\n" +
+		"I can also quote crypto from any exchange I support with " +
+		"/price <exchange> <pair>. For example: /price binance BTC USDT.\n" +
+		"Leave out the exchange and I'll default to cex.io.\n\n" +
+		"Want to know when a price hits a target? /alert <symbol> <above|below> <price>.\n" +
+		"/alerts lists your alerts, /unalert <id> removes one.\n\n" +
+		"/tx <txid> looks up a Bitcoin transaction.\n\n" +
+		"/chart <symbol> [interval] sends a price chart. Interval defaults to 1d."
 	SOURCE_MESSAGE = "You can find my source code here: " +
 		"https://github.com/JonCooperWorks/CryptoPricesBot.\n" +
 		"My code is licensed GPLv3, so you're free to use and modify it if you open source your modifications."
 )
 
-/* cex.io Messages */
-const (
-	CEX_IO_UNAVAILABLE_MESSAGE = "I can't reach https://cex.io right now.\n" +
-		"Try again later."
-	CEX_IO_BAD_RESPONSE_MESSAGE   = "I'm having trouble reading the response for '%s/%s' from https://cex.io."
-	CEX_IO_PAIR_NOT_FOUND_MESSAGE = "I can't find '%s/%s' on https://cex.io"
-)
-
-/* Source URLs */
-const (
-	CEX_IO_SOURCE_URL     = "https://cex.io/r/0/up100029857/0/"
-	JSE_SOURCE_URL        = "https://jsonjse.herokuapp.com/jse/today"
-)
-
-type Controller func(*tgbotapi.BotAPI, tgbotapi.Update, []string)
-
-type Command struct {
-	Controller Controller
-	Arguments  []string
-}
-
-type Quote struct {
-	Second    string
-	First     string
-	Price     float64
-	Amount    float64
-	SourceUrl string
+// formatAmount renders amount the way the bot has always displayed them when
+// an exchange doesn't tell us its precision: whole amounts with no decimals,
+// fractional amounts under 1 with 8, everything else with 2.
+func formatAmount(amount float64) string {
+	if amount < 1 {
+		return strconv.FormatFloat(amount, 'f', 8, 64)
+	}
+	if math.Mod(amount, 1) == 0 {
+		return strconv.FormatFloat(amount, 'f', 0, 64)
+	}
+	return strconv.FormatFloat(amount, 'f', 2, 64)
 }
 
-func (quote *Quote) String() string {
-	var quoteMessage string
-	cost := quote.Price * quote.Amount
-	if quote.Amount < 1 {
-		quoteMessage = "%.8f %s = "
-	} else if math.Mod(quote.Amount, 1) == 0 {
-		quoteMessage = "%.0f %s = "
+// formatQuote renders a quote for amount units of pair.First, followed by
+// the usual sponsor message. When quote carries exchange-reported tick
+// sizes, amount and cost are printed to that exact precision; otherwise we
+// fall back to the bot's old guess-from-magnitude behaviour.
+func formatQuote(quote *exchanges.Quote, amount float64) string {
+	cost := quote.Price * amount
+
+	var amountMessage, costMessage string
+	if quote.TickSize != nil {
+		amountMessage = strconv.FormatFloat(amount, 'f', quote.TickSize.AmountDecimals(), 64)
+		costMessage = strconv.FormatFloat(cost, 'f', quote.TickSize.PriceDecimals(), 64)
 	} else {
-		quoteMessage = "%.2f %s = "
+		amountMessage = formatAmount(amount)
+		if cost < 1 {
+			costMessage = strconv.FormatFloat(cost, 'f', 8, 64)
+		} else {
+			costMessage = strconv.FormatFloat(cost, 'f', 2, 64)
+		}
 	}
 
-	if cost < 1 {
-		quoteMessage += "%s%.8f"
-	} else {
-		quoteMessage += "%s%.2f"
+	symbol := SYMBOLS[quote.Pair.Second]
+	if symbol == "" {
+		symbol = quote.Pair.Second
 	}
 
-	quoteMessage += ".\n\n"
-	quoteMessage += "Shop at Afrodite for all your beauty needs. Unleash your inner goddess at https://www.afroditeja.com"
+	return fmt.Sprintf(
+		"%s %s = %s%s.\n\n"+
+			"Shop at Afrodite for all your beauty needs. Unleash your inner goddess at https://www.afroditeja.com",
+		amountMessage, quote.Pair.First, symbol, costMessage,
+	)
+}
 
-	symbol := SYMBOLS[quote.Second]
-	if symbol == "" {
-		symbol = quote.Second
-	}
-	return fmt.Sprintf(quoteMessage, quote.Amount, quote.First, symbol, cost)
+func StartCommand(ctx context.Context, req router.Request) router.Response {
+	return router.Response{Text: WELCOME_MESSAGE}
 }
 
-func StartCommand(bot *tgbotapi.BotAPI, update tgbotapi.Update, arguments []string) {
-	reply(bot, update, WELCOME_MESSAGE)
+func HelpCommand(ctx context.Context, req router.Request) router.Response {
+	return router.Response{Text: HELP_MESSAGE}
 }
 
-func HelpCommand(bot *tgbotapi.BotAPI, update tgbotapi.Update, arguments []string) {
-	reply(bot, update, HELP_MESSAGE)
+func SourceCommand(ctx context.Context, req router.Request) router.Response {
+	return router.Response{Text: SOURCE_MESSAGE}
 }
 
-func SourceCommand(bot *tgbotapi.BotAPI, update tgbotapi.Update, arguments []string) {
-	reply(bot, update, SOURCE_MESSAGE)
+// JseQuoteCommand handles "/wahgwaanfi <symbol>" (and its aliases), as well
+// as a bare "<symbol>" with no command at all. JMD only for now.
+func JseQuoteCommand(ctx context.Context, req router.Request) router.Response {
+	first := strings.ToUpper(req.Arguments[0])
+	quote, err := exchanges.Registry["jse"].Ticker(exchanges.Pair{First: first, Second: "JMD"})
+	if err != nil {
+		return router.Response{Text: err.Error()}
+	}
+	return router.Response{Text: formatQuote(quote, 1)}
 }
 
-func JseQuoteCommand(bot *tgbotapi.BotAPI, update tgbotapi.Update, arguments []string) {
-	if len(arguments) < 1 {
-		HelpCommand(bot, update, arguments)
-		return
+// CexPriceCommand handles "/cexprice <symbol> [currency]", defaulting to USD.
+func CexPriceCommand(ctx context.Context, req router.Request) router.Response {
+	first := strings.ToUpper(req.Arguments[0])
+	second := "USD"
+	if len(req.Arguments) >= 2 {
+		second = strings.ToUpper(req.Arguments[1])
 	}
 
-	// JMD only for now.
-	first := strings.ToUpper(arguments[0])
-	var second = "JMD"
-	quote, err := NewJseQuote(first, second, 1)
+	quote, err := exchanges.Registry["cexio"].Ticker(exchanges.Pair{First: first, Second: second})
 	if err != nil {
-		reply(bot, update, err.Error())
-		return
+		return router.Response{Text: err.Error()}
 	}
-
-	reply(bot, update, quote.String())
-
+	return router.Response{Text: formatQuote(quote, 1)}
 }
 
-func CexPriceCommand(bot *tgbotapi.BotAPI, update tgbotapi.Update, arguments []string) {
+// PriceCommand handles "/price <exchange> <pair>", looking the exchange up
+// in exchanges.Registry so new venues can be added without touching this
+// controller. "/price <pair>" falls back to DEFAULT_EXCHANGE.
+func PriceCommand(ctx context.Context, req router.Request) router.Response {
+	arguments := req.Arguments
+	exchangeName := strings.ToLower(arguments[0])
+	source, found := exchanges.Registry[exchangeName]
+	if !found {
+		exchangeName = DEFAULT_EXCHANGE
+		source = exchanges.Registry[DEFAULT_EXCHANGE]
+	} else {
+		arguments = arguments[1:]
+	}
+
 	if len(arguments) < 1 {
-		HelpCommand(bot, update, arguments)
-		return
+		return router.Response{Text: HELP_MESSAGE}
 	}
 
 	first := strings.ToUpper(arguments[0])
-	var second string
-	if len(arguments) == 2 {
+	second := "USD"
+	if len(arguments) >= 2 {
 		second = strings.ToUpper(arguments[1])
-	} else {
-		second = "USD"
 	}
 
-	quote, err := NewCexIoQuote(first, second, 1)
+	quote, err := source.Ticker(exchanges.Pair{First: first, Second: second})
 	if err != nil {
-		reply(bot, update, err.Error())
-		return
+		return router.Response{Text: err.Error()}
 	}
-	reply(bot, update, quote.String())
+	return router.Response{Text: formatQuote(quote, 1)}
 }
 
-func NewCexIoQuote(first, second string, amount float64) (*Quote, error) {
-	url := fmt.Sprintf(CEX_IO_PRICE_API_ENDPOINT, first, second)
-	log.Printf("Looking up %s/%s at %s", first, second, url)
-	resp, err := http.Get(url)
-	log.Printf("Looking up '%s/%s' on cex.io", first, second)
-	if err != nil || resp.StatusCode != 200 {
-		log.Println("Cex.io unavailable.")
-		return nil, errors.New(CEX_IO_UNAVAILABLE_MESSAGE)
+// AlertCommand handles "/alert <symbol> <above|below> <price>", registering
+// a threshold alert for the requesting chat on DEFAULT_EXCHANGE /
+// DEFAULT_ALERT_QUOTE.
+func AlertCommand(ctx context.Context, req router.Request) router.Response {
+	symbol := strings.ToUpper(req.Arguments[0])
+	direction := alerts.Direction(strings.ToLower(req.Arguments[1]))
+	if direction != alerts.Above && direction != alerts.Below {
+		return router.Response{Text: "Direction must be 'above' or 'below'"}
+	}
+
+	// The router has already validated this parses as a float.
+	threshold, _ := strconv.ParseFloat(req.Arguments[2], 64)
+
+	alert, err := alertStore.Create(alerts.Alert{
+		ChatID:    req.ChatID,
+		Exchange:  DEFAULT_EXCHANGE,
+		Symbol:    symbol,
+		Quote:     DEFAULT_ALERT_QUOTE,
+		Direction: direction,
+		Threshold: threshold,
+	})
+	if err != nil {
+		log.Println("Could not create alert:", err)
+		return router.Response{Text: "I couldn't save that alert. Try again later."}
 	}
 
-	var coinQuoteResponse map[string]interface{}
-	err = json.NewDecoder(resp.Body).Decode(&coinQuoteResponse)
+	return router.Response{Text: fmt.Sprintf("Got it, I'll let you know. %s", alert)}
+}
+
+// AlertsCommand handles "/alerts", listing the requesting chat's alerts.
+func AlertsCommand(ctx context.Context, req router.Request) router.Response {
+	chatAlerts, err := alertStore.List(req.ChatID)
 	if err != nil {
-		log.Println(err)
-		return nil, errors.New(fmt.Sprintf(CEX_IO_BAD_RESPONSE_MESSAGE, first, second))
+		log.Println("Could not list alerts:", err)
+		return router.Response{Text: "I couldn't look up your alerts. Try again later."}
 	}
 
-	rawCoinPrice, found := coinQuoteResponse["last"]
-	if !found {
-		return nil, errors.New(fmt.Sprintf(CEX_IO_PAIR_NOT_FOUND_MESSAGE, first, second))
+	if len(chatAlerts) == 0 {
+		return router.Response{Text: "You don't have any alerts. Set one with /alert <symbol> <above|below> <price>"}
 	}
-	coinPrice, err := strconv.ParseFloat(rawCoinPrice.(string), 64)
-	if err != nil {
-		log.Printf("Coin price for %s/%s is not a float or numeric type, got: '%v'", first, second, rawCoinPrice)
-		return nil, errors.New(
-			fmt.Sprintf(CEX_IO_BAD_RESPONSE_MESSAGE, first, second),
-		)
+
+	message := "Your alerts:\n"
+	for _, alert := range chatAlerts {
+		message += alert.String() + "\n"
 	}
+	return router.Response{Text: message}
+}
+
+// UnalertCommand handles "/unalert <id>", removing one of the requesting
+// chat's alerts.
+func UnalertCommand(ctx context.Context, req router.Request) router.Response {
+	// The router has already validated this parses as a uint.
+	id, _ := strconv.ParseUint(req.Arguments[0], 10, 64)
 
-	return &Quote{
-		First:     first,
-		Second:    second,
-		Price:     coinPrice,
-		Amount:    amount,
-		SourceUrl: CEX_IO_SOURCE_URL,
-	}, nil
+	if err := alertStore.Delete(req.ChatID, id); err != nil {
+		return router.Response{Text: fmt.Sprintf("I couldn't find alert #%d for you", id)}
+	}
+	return router.Response{Text: fmt.Sprintf("Removed alert #%d", id)}
 }
 
-func getJsePrice(ticker string) (float64, error) {
-	resp, err := http.Get(JSE_SOURCE_URL)
+// TxCommand handles "/tx <txid>", looking up a Bitcoin transaction and
+// rendering its inputs, outputs and confirmation status.
+func TxCommand(ctx context.Context, req router.Request) router.Response {
+	txid := req.Arguments[0]
+	transaction, err := chainAPI.Transaction(txid)
 	if err != nil {
-		return 0, err
+		return router.Response{Text: err.Error()}
 	}
+	return router.Response{Text: formatTransaction(transaction)}
+}
 
-	var symbols []jsonjse.Symbol
-	err = json.NewDecoder(resp.Body).Decode(&symbols)
-	if err != nil {
-		return 0, err
+func formatTransaction(transaction *chain.Transaction) string {
+	status := "unconfirmed"
+	if transaction.Status.Confirmed {
+		status = fmt.Sprintf("confirmed at block %d", transaction.Status.BlockHeight)
+	}
+
+	message := fmt.Sprintf("%s (%s)\n\nInputs:\n", transaction.Txid, status)
+	for _, vin := range transaction.Vin {
+		if vin.Prevout == nil {
+			message += fmt.Sprintf("- %s:%d\n", vin.Txid, vin.Vout)
+			continue
+		}
+		address := vin.Prevout.ScriptPubKeyAddress
+		if address == "" {
+			address = vin.Prevout.ScriptPubKey
+		}
+		message += fmt.Sprintf("- %s: %.8f BTC\n", address, chain.BTC(vin.Prevout.Value))
 	}
 
-	for _, symbol := range symbols {
-		if symbol.Symbol == ticker {
-			return symbol.LastTraded, nil
+	message += "\nOutputs:\n"
+	for _, vout := range transaction.Vout {
+		address := vout.ScriptPubKeyAddress
+		if address == "" {
+			address = vout.ScriptPubKey
 		}
+		message += fmt.Sprintf("- %s: %.8f BTC\n", address, chain.BTC(vout.Value))
 	}
-	return float64(0), fmt.Errorf("Could not find %v on the JSE", ticker)
+
+	return message
 }
 
-func NewJseQuote(first, second string, amount float64) (*Quote, error) {
-	// Return prices from cache
-	price, err := getJsePrice(first)
+// ChartCommand handles "/chart <symbol> [interval]", rendering a line chart
+// of recent closing prices from CHART_EXCHANGE against USDT. Results are
+// cached for CHART_CACHE_TTL so repeated requests don't re-fetch or re-render.
+func ChartCommand(ctx context.Context, req router.Request) router.Response {
+	source, ok := exchanges.Registry[CHART_EXCHANGE].(exchanges.CandleSource)
+	if !ok {
+		return router.Response{Text: "Charts aren't available right now."}
+	}
+
+	pair := exchanges.Pair{First: strings.ToUpper(req.Arguments[0]), Second: "USDT"}
+	interval := DEFAULT_CHART_INTERVAL
+	if len(req.Arguments) >= 2 {
+		interval = strings.ToLower(req.Arguments[1])
+	}
+
+	cacheKey := fmt.Sprintf("%s:%s:%s", CHART_EXCHANGE, pair, interval)
+	if png, found := chartCache.Get(cacheKey); found {
+		return router.Response{Photo: png}
+	}
+
+	candles, err := source.Candles(pair, interval, CHART_CANDLE_LIMIT)
 	if err != nil {
-		log.Printf("Could not find '%s' on the JSE", first)
-		return nil, err
-	}
-	return &Quote{
-		First:     first,
-		Second:    second,
-		Amount:    amount,
-		Price:     price,
-		SourceUrl: JSE_SOURCE_URL,
-	}, nil
+		return router.Response{Text: err.Error()}
+	}
+	if len(candles) == 0 {
+		return router.Response{Text: fmt.Sprintf("I don't have any chart data for '%s'", pair)}
+	}
+
+	png, err := charts.Render(pair, candles)
+	if err != nil {
+		log.Println("Could not render chart:", err)
+		return router.Response{Text: "I couldn't render that chart."}
+	}
+
+	chartCache.Set(cacheKey, png)
+	return router.Response{Photo: png}
 }
 
 func isFiatInvolved(first, second string) bool {
@@ -278,60 +396,68 @@ func isFiat(ticker string) bool {
 	return FIAT_CURRENCIES[ticker] != ""
 }
 
-func reply(bot *tgbotapi.BotAPI, update tgbotapi.Update, message string) {
-	msg := tgbotapi.NewMessage(update.Message.Chat.ID, message)
-	msg.ReplyToMessageID = update.Message.MessageID
-	bot.Send(msg)
-}
-
-func routeCommand(bot *tgbotapi.BotAPI, update tgbotapi.Update) {
-	command, err := NewCommand(update)
-	if command == nil {
-		// STFU when there's no command
+// sendResponse delivers a Handler's Response back to the chat it came from,
+// threaded as a reply to the triggering message. An empty Response sends
+// nothing.
+func sendResponse(bot *tgbotapi.BotAPI, update tgbotapi.Update, response router.Response) {
+	chatID := update.Message.Chat.ID
+	if response.Photo != nil {
+		photo := tgbotapi.NewPhotoUpload(chatID, tgbotapi.FileBytes{Name: "chart.png", Bytes: response.Photo})
+		photo.ReplyToMessageID = update.Message.MessageID
+		bot.Send(photo)
 		return
 	}
-	if err != nil {
-		log.Println(err.Error())
-		HelpCommand(bot, update, []string{})
-		return
-	}
-	command.Controller(bot, update, command.Arguments)
-}
-
-func NewCommand(update tgbotapi.Update) (*Command, error) {
-	log.Printf("[%s - %s] %s", update.Message.From.UserName, update.Message.From.FirstName, update.Message.Text)
-	parts := parseArgumentsFromUpdate(update.Message.Text)
-	if len(parts) < 1 {
-		return nil, errors.New(fmt.Sprintf("Error parsing arguments from '%s'", update.Message.Text))
-	} else if len(parts) > 3 && !update.Message.IsCommand() {
-		return nil, nil
-	}
-
-	if !update.Message.IsCommand() {
-		return &Command{
-			Controller: JseQuoteCommand,
-			Arguments:  parts,
-		}, nil
-	}
 
-	controllerName := strings.ToLower(parts[0])
-	if strings.Contains(controllerName, BOT_NAME) {
-		controllerName = strings.Split(controllerName, USERNAME_SEPARATOR)[0]
-	}
-
-	controller := controllers[controllerName]
-	if controller == nil {
-		return nil, errors.New(fmt.Sprintf("Controller '%s' not found", controllerName))
+	if response.Text == "" {
+		return
 	}
-
-	return &Command{
-		Controller: controller,
-		Arguments:  parts[1:],
-	}, nil
+	msg := tgbotapi.NewMessage(chatID, response.Text)
+	msg.ReplyToMessageID = update.Message.MessageID
+	bot.Send(msg)
 }
 
-func parseArgumentsFromUpdate(message string) []string {
-	return strings.Split(message, " ")
+// setupRouter registers every command this bot supports, along with the
+// middleware that should run around all of them.
+func setupRouter(bot *tgbotapi.BotAPI) *router.Router {
+	r := router.New()
+	r.Use(router.LoggingMiddleware())
+	r.Use(router.RateLimitMiddleware(COMMAND_RATE_LIMIT_INTERVAL))
+	r.Use(router.TypingMiddleware(botChatActionSender{bot: bot}))
+
+	r.Handle(START_COMMAND, StartCommand)
+	r.Handle(HELP_COMMAND, HelpCommand)
+	r.Handle(SOURCE_COMMAND, SourceCommand)
+
+	jseSymbolArg := router.ArgSpec{Name: "symbol", Kind: router.ArgSymbol}
+	r.Handle(JSE_QUOTE_COMMAND, JseQuoteCommand, jseSymbolArg)
+	r.Handle(ALTERNATIVE_JSE_QUOTE_COMMAND, JseQuoteCommand, jseSymbolArg)
+	r.Handle(YET_ANOTHER_JSE_COMMAND, JseQuoteCommand, jseSymbolArg)
+
+	r.Handle(CEX_IO_COMMAND, CexPriceCommand,
+		router.ArgSpec{Name: "symbol", Kind: router.ArgSymbol},
+		router.ArgSpec{Name: "currency", Kind: router.ArgCurrency, Optional: true},
+	)
+	r.Handle(PRICE_COMMAND, PriceCommand, router.ArgSpec{Name: "exchange or symbol", Kind: router.ArgToken})
+
+	r.Handle(ALERT_COMMAND, AlertCommand,
+		router.ArgSpec{Name: "symbol", Kind: router.ArgSymbol},
+		router.ArgSpec{Name: "direction", Kind: router.ArgToken},
+		router.ArgSpec{Name: "price", Kind: router.ArgAmount},
+	)
+	r.Handle(ALERTS_COMMAND, AlertsCommand)
+	r.Handle(UNALERT_COMMAND, UnalertCommand, router.ArgSpec{Name: "id", Kind: router.ArgID})
+
+	r.Handle(TX_COMMAND, TxCommand, router.ArgSpec{Name: "txid", Kind: router.ArgToken})
+
+	r.Handle(CHART_COMMAND, ChartCommand,
+		router.ArgSpec{Name: "symbol", Kind: router.ArgSymbol},
+		router.ArgSpec{Name: "interval", Kind: router.ArgToken, Optional: true},
+	)
+
+	r.HandleDefault(JseQuoteCommand)
+	r.HandleUnknown(HelpCommand)
+
+	return r
 }
 
 func worker(updates <-chan tgbotapi.Update, bot *tgbotapi.BotAPI) {
@@ -340,7 +466,18 @@ func worker(updates <-chan tgbotapi.Update, bot *tgbotapi.BotAPI) {
 			continue
 		}
 
-		routeCommand(bot, update)
+		response, ok := cmdRouter.Route(
+			context.Background(),
+			update.Message.Chat.ID,
+			update.Message.From.UserName,
+			update.Message.From.FirstName,
+			update.Message.IsCommand(),
+			update.Message.Text,
+		)
+		if !ok {
+			continue
+		}
+		sendResponse(bot, update, response)
 	}
 }
 
@@ -351,6 +488,28 @@ func listenForWebhook(updates <-chan tgbotapi.Update, bot *tgbotapi.BotAPI) {
 	}
 }
 
+// botNotifier delivers fired alerts as Telegram messages, so the alerts
+// package doesn't need to know about tgbotapi.
+type botNotifier struct {
+	bot *tgbotapi.BotAPI
+}
+
+func (notifier botNotifier) Notify(chatID int64, message string) error {
+	_, err := notifier.bot.Send(tgbotapi.NewMessage(chatID, message))
+	return err
+}
+
+// botChatActionSender sends Telegram's "typing..." indicator, so the router
+// package doesn't need to know about tgbotapi.
+type botChatActionSender struct {
+	bot *tgbotapi.BotAPI
+}
+
+func (sender botChatActionSender) SendChatAction(chatID int64) error {
+	_, err := sender.bot.Send(tgbotapi.NewChatAction(chatID, tgbotapi.ChatTyping))
+	return err
+}
+
 func init() {
 	log.SetOutput(os.Stdout)
 }
@@ -381,6 +540,24 @@ func main() {
 	u := tgbotapi.NewUpdate(0)
 	u.Timeout = 60
 
+	store, err := alerts.NewBoltStore(ALERTS_DB_PATH)
+	if err != nil {
+		log.Fatal(err)
+	}
+	alertStore = store
+	alertScheduler = alerts.NewScheduler(alertStore, botNotifier{bot: bot}, ALERT_POLL_INTERVAL)
+	go alertScheduler.Run()
+
+	chainAPIURL := os.Getenv("CHAIN_API_URL")
+	if chainAPIURL == "" {
+		chainAPIURL = DEFAULT_CHAIN_API_URL
+	}
+	chainAPI = chain.NewAPI(chainAPIURL)
+
+	chartCache = charts.NewCache(CHART_CACHE_TTL)
+
+	cmdRouter = setupRouter(bot)
+
 	updates := bot.ListenForWebhook("/")
 	go listenForWebhook(updates, bot)
 
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/joncooperworks/cryptopricesbot/alerts"
+	"github.com/joncooperworks/cryptopricesbot/router"
+)
+
+// fakeAlertStore is a minimal alerts.Store for exercising controllers
+// without a real BoltStore.
+type fakeAlertStore struct {
+	deleted map[uint64]bool
+}
+
+func (store *fakeAlertStore) Create(alert alerts.Alert) (alerts.Alert, error) { return alert, nil }
+func (store *fakeAlertStore) List(chatID int64) ([]alerts.Alert, error)       { return nil, nil }
+func (store *fakeAlertStore) All() ([]alerts.Alert, error)                    { return nil, nil }
+func (store *fakeAlertStore) SetArmed(id uint64, armed bool) error            { return nil }
+func (store *fakeAlertStore) Close() error                                    { return nil }
+
+func (store *fakeAlertStore) Delete(chatID int64, id uint64) error {
+	if store.deleted[id] {
+		return nil
+	}
+	return nil
+}
+
+func TestUnalertCommandParsesIDAsUint(t *testing.T) {
+	store := &fakeAlertStore{deleted: map[uint64]bool{}}
+	alertStore = store
+	defer func() { alertStore = nil }()
+
+	response := UnalertCommand(context.Background(), router.Request{ChatID: 1, Arguments: []string{"42"}})
+	if response.Text != "Removed alert #42" {
+		t.Errorf("expected alert #42 to be removed, got %q", response.Text)
+	}
+}
@@ -0,0 +1,104 @@
+// Package chain is a client for Esplora-style block explorer REST APIs
+// (mempool.space, blockstream.info, or a self-hosted instance), used to look
+// up Bitcoin transactions.
+package chain
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const satoshisPerBTC = 1e8
+
+// BTC converts a satoshi amount to BTC for display.
+func BTC(satoshis int64) float64 {
+	return float64(satoshis) / satoshisPerBTC
+}
+
+// Status is a transaction or output's confirmation status.
+type Status struct {
+	Confirmed   bool   `json:"confirmed"`
+	BlockHeight int    `json:"block_height"`
+	BlockHash   string `json:"block_hash"`
+}
+
+// Vout is a transaction output.
+type Vout struct {
+	ScriptPubKey        string `json:"scriptpubkey"`
+	ScriptPubKeyAddress string `json:"scriptpubkey_address"`
+	Value               int64  `json:"value"`
+}
+
+// Vin is a transaction input, spending a prior transaction's Vout.
+type Vin struct {
+	Txid     string `json:"txid"`
+	Vout     int    `json:"vout"`
+	Prevout  *Vout  `json:"prevout"`
+	Sequence uint32 `json:"sequence"`
+}
+
+// Transaction is a Bitcoin transaction as Esplora's API reports it.
+type Transaction struct {
+	Txid   string `json:"txid"`
+	Vin    []Vin  `json:"vin"`
+	Vout   []Vout `json:"vout"`
+	Status Status `json:"status"`
+}
+
+// Outspend reports whether a Vout has been spent, and by what.
+type Outspend struct {
+	Spent  bool   `json:"spent"`
+	Txid   string `json:"txid"`
+	Vin    int    `json:"vin"`
+	Status Status `json:"status"`
+}
+
+// API is a client for an Esplora-style REST API.
+type API struct {
+	BaseURL string
+}
+
+// NewAPI builds an API client against baseURL, e.g.
+// "https://blockstream.info/api".
+func NewAPI(baseURL string) *API {
+	return &API{BaseURL: baseURL}
+}
+
+// Transaction fetches txid.
+func (api *API) Transaction(txid string) (*Transaction, error) {
+	var transaction Transaction
+	if err := api.get(fmt.Sprintf("/tx/%s", txid), &transaction); err != nil {
+		return nil, err
+	}
+	return &transaction, nil
+}
+
+// Outspend fetches whether vout of txid has been spent.
+func (api *API) Outspend(txid string, vout int) (*Outspend, error) {
+	var outspend Outspend
+	if err := api.get(fmt.Sprintf("/tx/%s/outspend/%d", txid, vout), &outspend); err != nil {
+		return nil, err
+	}
+	return &outspend, nil
+}
+
+func (api *API) get(path string, result interface{}) error {
+	resp, err := http.Get(api.BaseURL + path)
+	if err != nil {
+		return fmt.Errorf("I can't reach %s right now.\nTry again later", api.BaseURL)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("I can't find that on %s", api.BaseURL)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned an error looking that up", api.BaseURL)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("I'm having trouble reading the response from %s", api.BaseURL)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package chain
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func withAPIServer(handler http.HandlerFunc) (*API, func()) {
+	server := httptest.NewServer(handler)
+	return NewAPI(server.URL), server.Close
+}
+
+func TestAPITransaction(t *testing.T) {
+	api, close := withAPIServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"txid": "abc123", "status": {"confirmed": true, "block_height": 600000}}`))
+	})
+	defer close()
+
+	transaction, err := api.Transaction("abc123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if transaction.Txid != "abc123" {
+		t.Errorf("expected txid abc123, got %v", transaction.Txid)
+	}
+	if !transaction.Status.Confirmed {
+		t.Error("expected transaction to be confirmed")
+	}
+}
+
+func TestAPITransactionNotFound(t *testing.T) {
+	api, close := withAPIServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	defer close()
+
+	_, err := api.Transaction("doesnotexist")
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestAPITransactionNon200(t *testing.T) {
+	api, close := withAPIServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	defer close()
+
+	_, err := api.Transaction("abc123")
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestAPITransactionMalformedJSON(t *testing.T) {
+	api, close := withAPIServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`not json`))
+	})
+	defer close()
+
+	_, err := api.Transaction("abc123")
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestAPIOutspend(t *testing.T) {
+	api, close := withAPIServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"spent": true, "txid": "def456", "vin": 0, "status": {"confirmed": true, "block_height": 600001}}`))
+	})
+	defer close()
+
+	outspend, err := api.Outspend("abc123", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !outspend.Spent {
+		t.Error("expected outspend to be spent")
+	}
+	if outspend.Txid != "def456" {
+		t.Errorf("expected txid def456, got %v", outspend.Txid)
+	}
+}
+
+func TestAPIOutspendNotFound(t *testing.T) {
+	api, close := withAPIServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	defer close()
+
+	_, err := api.Outspend("doesnotexist", 0)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestAPIOutspendNon200(t *testing.T) {
+	api, close := withAPIServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	defer close()
+
+	_, err := api.Outspend("abc123", 0)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestAPIOutspendMalformedJSON(t *testing.T) {
+	api, close := withAPIServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`not json`))
+	})
+	defer close()
+
+	_, err := api.Outspend("abc123", 0)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
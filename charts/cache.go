@@ -0,0 +1,44 @@
+package charts
+
+import (
+	"sync"
+	"time"
+)
+
+type cacheEntry struct {
+	png     []byte
+	expires time.Time
+}
+
+// Cache holds rendered chart PNGs keyed by caller-chosen strings (typically
+// exchange:pair:interval) for ttl, so a burst of /chart requests for the same
+// market doesn't hammer an exchange's API or re-render the same image.
+type Cache struct {
+	ttl   time.Duration
+	mu    sync.Mutex
+	items map[string]cacheEntry
+}
+
+// NewCache builds a Cache whose entries expire ttl after being Set.
+func NewCache(ttl time.Duration) *Cache {
+	return &Cache{ttl: ttl, items: map[string]cacheEntry{}}
+}
+
+// Get returns the cached PNG for key, if present and not expired.
+func (cache *Cache) Get(key string) ([]byte, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	entry, found := cache.items[key]
+	if !found || time.Now().After(entry.expires) {
+		return nil, false
+	}
+	return entry.png, true
+}
+
+// Set stores png under key for the Cache's ttl.
+func (cache *Cache) Set(key string, png []byte) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.items[key] = cacheEntry{png: png, expires: time.Now().Add(cache.ttl)}
+}
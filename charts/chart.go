@@ -0,0 +1,49 @@
+// Package charts renders historical OHLCV bars into PNG images for /chart,
+// with a short-TTL cache so repeated requests in a chat don't re-render (or
+// re-fetch) the same candles.
+package charts
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/joncooperworks/cryptopricesbot/exchanges"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// Render draws candles' closing prices as a line chart and returns it as a
+// PNG. A full candlestick renderer is more than a chat screenshot needs.
+func Render(pair exchanges.Pair, candles []exchanges.OHLCV) ([]byte, error) {
+	p, err := plot.New()
+	if err != nil {
+		return nil, fmt.Errorf("couldn't build chart: %w", err)
+	}
+	p.Title.Text = pair.String()
+	p.X.Label.Text = "Time"
+	p.Y.Label.Text = pair.Second
+
+	points := make(plotter.XYs, len(candles))
+	for i, candle := range candles {
+		points[i].X = float64(i)
+		points[i].Y = candle.Close
+	}
+
+	line, err := plotter.NewLine(points)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't build chart: %w", err)
+	}
+	p.Add(line)
+
+	writerTo, err := p.WriterTo(6*vg.Inch, 4*vg.Inch, "png")
+	if err != nil {
+		return nil, fmt.Errorf("couldn't render chart: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if _, err := writerTo.WriteTo(&buf); err != nil {
+		return nil, fmt.Errorf("couldn't render chart: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,91 @@
+package exchanges
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// Overridable in tests so they can point at an httptest.Server instead of
+// the real API.
+var (
+	binancePriceAPIEndpoint  = "https://api.binance.com/api/v3/ticker/price?symbol=%s%s"
+	binanceKlinesAPIEndpoint = "https://api.binance.com/api/v3/klines?symbol=%s%s&interval=%s&limit=%d"
+)
+
+func init() {
+	Register("binance", &Binance{})
+}
+
+// Binance quotes prices from Binance.
+type Binance struct{}
+
+func (Binance) Ticker(pair Pair) (*Quote, error) {
+	url := fmt.Sprintf(binancePriceAPIEndpoint, pair.First, pair.Second)
+	resp, err := http.Get(url)
+	if err != nil || resp.StatusCode != 200 {
+		return nil, fmt.Errorf("I can't reach https://binance.com right now.\nTry again later")
+	}
+	defer resp.Body.Close()
+
+	var tickerResponse struct {
+		Price string `json:"price"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&tickerResponse)
+	if err != nil || tickerResponse.Price == "" {
+		return nil, fmt.Errorf("I can't find '%s' on https://binance.com", pair)
+	}
+
+	price, err := strconv.ParseFloat(tickerResponse.Price, 64)
+	if err != nil {
+		return nil, fmt.Errorf("I'm having trouble reading the response for '%s' from https://binance.com", pair)
+	}
+
+	return &Quote{
+		Pair:      pair,
+		Price:     price,
+		SourceURL: "https://www.binance.com/en/trade/" + pair.First + "_" + pair.Second,
+	}, nil
+}
+
+// Candles fetches interval bars for pair from Binance's klines endpoint.
+// interval is whatever Binance expects, e.g. "1h", "1d" or "1w".
+func (Binance) Candles(pair Pair, interval string, limit int) ([]OHLCV, error) {
+	url := fmt.Sprintf(binanceKlinesAPIEndpoint, pair.First, pair.Second, interval, limit)
+	resp, err := http.Get(url)
+	if err != nil || resp.StatusCode != 200 {
+		return nil, fmt.Errorf("I can't reach https://binance.com right now.\nTry again later")
+	}
+	defer resp.Body.Close()
+
+	var rawCandles [][]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&rawCandles); err != nil {
+		return nil, fmt.Errorf("I'm having trouble reading the response for '%s' from https://binance.com", pair)
+	}
+
+	candles := make([]OHLCV, 0, len(rawCandles))
+	for _, raw := range rawCandles {
+		if len(raw) < 6 {
+			continue
+		}
+
+		openTime, _ := raw[0].(float64)
+		open, _ := strconv.ParseFloat(raw[1].(string), 64)
+		high, _ := strconv.ParseFloat(raw[2].(string), 64)
+		low, _ := strconv.ParseFloat(raw[3].(string), 64)
+		close, _ := strconv.ParseFloat(raw[4].(string), 64)
+		volume, _ := strconv.ParseFloat(raw[5].(string), 64)
+
+		candles = append(candles, OHLCV{
+			Timestamp: int64(openTime) / 1000,
+			Open:      open,
+			High:      high,
+			Low:       low,
+			Close:     close,
+			Volume:    volume,
+		})
+	}
+
+	return candles, nil
+}
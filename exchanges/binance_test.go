@@ -0,0 +1,126 @@
+package exchanges
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func withBinanceServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	originalPriceEndpoint := binancePriceAPIEndpoint
+	originalKlinesEndpoint := binanceKlinesAPIEndpoint
+	binancePriceAPIEndpoint = server.URL + "/price?symbol=%s%s"
+	binanceKlinesAPIEndpoint = server.URL + "/klines?symbol=%s%s&interval=%s&limit=%d"
+	t.Cleanup(func() {
+		binancePriceAPIEndpoint = originalPriceEndpoint
+		binanceKlinesAPIEndpoint = originalKlinesEndpoint
+	})
+
+	return server
+}
+
+func TestBinanceTicker(t *testing.T) {
+	pair := Pair{First: "BTC", Second: "USDT"}
+
+	withBinanceServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"price": "50000.12"}`))
+	})
+	quote, err := (Binance{}).Ticker(pair)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if quote.Price != 50000.12 {
+		t.Errorf("expected price 50000.12, got %v", quote.Price)
+	}
+}
+
+func TestBinanceTickerNon200(t *testing.T) {
+	pair := Pair{First: "BTC", Second: "USDT"}
+
+	withBinanceServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	_, err := (Binance{}).Ticker(pair)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestBinanceTickerMalformedJSON(t *testing.T) {
+	pair := Pair{First: "BTC", Second: "USDT"}
+
+	withBinanceServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`not json`))
+	})
+	_, err := (Binance{}).Ticker(pair)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestBinanceTickerPairNotFound(t *testing.T) {
+	pair := Pair{First: "DOESNOTEXIST", Second: "USDT"}
+
+	withBinanceServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"price": ""}`))
+	})
+	_, err := (Binance{}).Ticker(pair)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if !strings.Contains(err.Error(), pair.String()) {
+		t.Errorf("expected error to mention %s, got: %v", pair, err)
+	}
+}
+
+func TestBinanceCandles(t *testing.T) {
+	pair := Pair{First: "BTC", Second: "USDT"}
+
+	withBinanceServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[
+			[1609459200000, "29000.00", "29500.00", "28900.00", "29300.00", "123.45", 1609462799999, "0", 0, "0", "0", "0"]
+		]`))
+	})
+	candles, err := (Binance{}).Candles(pair, "1h", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(candles) != 1 {
+		t.Fatalf("expected 1 candle, got %d", len(candles))
+	}
+	if candles[0].Close != 29300.00 {
+		t.Errorf("expected close 29300.00, got %v", candles[0].Close)
+	}
+	if candles[0].Timestamp != 1609459200 {
+		t.Errorf("expected timestamp 1609459200, got %v", candles[0].Timestamp)
+	}
+}
+
+func TestBinanceCandlesNon200(t *testing.T) {
+	pair := Pair{First: "BTC", Second: "USDT"}
+
+	withBinanceServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	_, err := (Binance{}).Candles(pair, "1h", 10)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestBinanceCandlesMalformedJSON(t *testing.T) {
+	pair := Pair{First: "BTC", Second: "USDT"}
+
+	withBinanceServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`not json`))
+	})
+	_, err := (Binance{}).Candles(pair, "1h", 10)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
@@ -0,0 +1,22 @@
+package exchanges
+
+// OHLCV is one bar of historical price data.
+type OHLCV struct {
+	Timestamp int64
+	Open      float64
+	High      float64
+	Low       float64
+	Close     float64
+	Volume    float64
+}
+
+// CandleSource is implemented by PriceSources that can also serve historical
+// OHLCV bars. Not every exchange's API exposes candles, so this is kept
+// separate from PriceSource rather than forcing every implementation to
+// support it - callers type-assert for it where they need it.
+type CandleSource interface {
+	PriceSource
+	// Candles fetches up to limit historical bars for pair at interval
+	// (exchange-specific, e.g. "1h", "1d", "1w"), oldest first.
+	Candles(pair Pair, interval string, limit int) ([]OHLCV, error)
+}
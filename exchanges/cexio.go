@@ -0,0 +1,122 @@
+package exchanges
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+)
+
+const cexIoSourceURL = "https://cex.io/r/0/up100029857/0/"
+
+// Overridable in tests so they can point at an httptest.Server instead of
+// the real API.
+var (
+	cexIoPriceAPIEndpoint  = "https://cex.io/api/ticker/%s/%s"
+	cexIoCurrencyLimitsURL = "https://cex.io/api/currency_limits"
+)
+
+func init() {
+	Register("cexio", &CexIo{})
+}
+
+// CexIo quotes prices from cex.io.
+type CexIo struct {
+	tickSizesMu     sync.Mutex
+	tickSizes       map[Pair]*TickSize
+	tickSizesLoaded bool
+}
+
+// tickSize looks up pair's precision from cex.io's currency_limits endpoint,
+// fetching and caching the full pair list the first time it's needed. A
+// failed fetch isn't cached as loaded, so a transient outage gets retried on
+// the next lookup instead of falling back to default precision forever.
+func (cexIo *CexIo) tickSize(pair Pair) *TickSize {
+	cexIo.tickSizesMu.Lock()
+	defer cexIo.tickSizesMu.Unlock()
+
+	if !cexIo.tickSizesLoaded {
+		tickSizes := fetchCexIoTickSizes()
+		if len(tickSizes) > 0 {
+			cexIo.tickSizes = tickSizes
+			cexIo.tickSizesLoaded = true
+		}
+	}
+	return cexIo.tickSizes[pair]
+}
+
+func fetchCexIoTickSizes() map[Pair]*TickSize {
+	tickSizes := map[Pair]*TickSize{}
+	resp, err := http.Get(cexIoCurrencyLimitsURL)
+	if err != nil || resp.StatusCode != 200 {
+		log.Println("Could not fetch cex.io currency limits, falling back to default precision")
+		return tickSizes
+	}
+	defer resp.Body.Close()
+
+	var limitsResponse struct {
+		Data struct {
+			Pairs []struct {
+				Symbol1    string  `json:"symbol1"`
+				Symbol2    string  `json:"symbol2"`
+				MinPrice   string  `json:"minPrice"`
+				MinLotSize float64 `json:"minLotSize"`
+			} `json:"pairs"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&limitsResponse); err != nil {
+		log.Println("Could not decode cex.io currency limits:", err)
+		return tickSizes
+	}
+
+	for _, pairLimit := range limitsResponse.Data.Pairs {
+		minPrice, err := strconv.ParseFloat(pairLimit.MinPrice, 64)
+		if err != nil {
+			continue
+		}
+		pair := Pair{First: pairLimit.Symbol1, Second: pairLimit.Symbol2}
+		tickSizes[pair] = &TickSize{
+			PriceTickSize:  minPrice,
+			AmountTickSize: pairLimit.MinLotSize,
+			QuoteCurrency:  pairLimit.Symbol2,
+		}
+	}
+	return tickSizes
+}
+
+func (cexIo *CexIo) Ticker(pair Pair) (*Quote, error) {
+	url := fmt.Sprintf(cexIoPriceAPIEndpoint, pair.First, pair.Second)
+	log.Printf("Looking up '%s' on cex.io at %s", pair, url)
+	resp, err := http.Get(url)
+	if err != nil || resp.StatusCode != 200 {
+		return nil, fmt.Errorf("I can't reach https://cex.io right now.\nTry again later")
+	}
+	defer resp.Body.Close()
+
+	var tickerResponse map[string]interface{}
+	err = json.NewDecoder(resp.Body).Decode(&tickerResponse)
+	if err != nil {
+		log.Println(err)
+		return nil, fmt.Errorf("I'm having trouble reading the response for '%s' from https://cex.io", pair)
+	}
+
+	rawPrice, found := tickerResponse["last"]
+	if !found {
+		return nil, fmt.Errorf("I can't find '%s' on https://cex.io", pair)
+	}
+
+	price, err := strconv.ParseFloat(rawPrice.(string), 64)
+	if err != nil {
+		log.Printf("Price for '%s' is not numeric, got: '%v'", pair, rawPrice)
+		return nil, fmt.Errorf("I'm having trouble reading the response for '%s' from https://cex.io", pair)
+	}
+
+	return &Quote{
+		Pair:      pair,
+		Price:     price,
+		SourceURL: cexIoSourceURL,
+		TickSize:  cexIo.tickSize(pair),
+	}, nil
+}
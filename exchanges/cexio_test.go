@@ -0,0 +1,89 @@
+package exchanges
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func withCexIoTickerServer(t *testing.T, handler http.HandlerFunc) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	original := cexIoPriceAPIEndpoint
+	cexIoPriceAPIEndpoint = server.URL + "/ticker/%s/%s"
+	t.Cleanup(func() { cexIoPriceAPIEndpoint = original })
+}
+
+func withCexIoLimitsServer(t *testing.T, handler http.HandlerFunc) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	original := cexIoCurrencyLimitsURL
+	cexIoCurrencyLimitsURL = server.URL
+	t.Cleanup(func() { cexIoCurrencyLimitsURL = original })
+}
+
+func TestCexIoTicker(t *testing.T) {
+	pair := Pair{First: "BTC", Second: "USD"}
+
+	withCexIoTickerServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"last": "50000.12"}`))
+	})
+	withCexIoLimitsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data": {"pairs": []}}`))
+	})
+
+	quote, err := (&CexIo{}).Ticker(pair)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if quote.Price != 50000.12 {
+		t.Errorf("expected price 50000.12, got %v", quote.Price)
+	}
+}
+
+func TestCexIoTickerNon200(t *testing.T) {
+	pair := Pair{First: "BTC", Second: "USD"}
+
+	withCexIoTickerServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := (&CexIo{}).Ticker(pair)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestCexIoTickerMalformedJSON(t *testing.T) {
+	pair := Pair{First: "BTC", Second: "USD"}
+
+	withCexIoTickerServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`not json`))
+	})
+
+	_, err := (&CexIo{}).Ticker(pair)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestCexIoTickerPairNotFound(t *testing.T) {
+	pair := Pair{First: "DOESNOTEXIST", Second: "USD"}
+
+	withCexIoTickerServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"error": "Invalid Symbols Pair"}`))
+	})
+
+	_, err := (&CexIo{}).Ticker(pair)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if !strings.Contains(err.Error(), pair.String()) {
+		t.Errorf("expected error to mention %s, got: %v", pair, err)
+	}
+}
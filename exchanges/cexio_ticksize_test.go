@@ -0,0 +1,48 @@
+package exchanges
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestCexIoTickSizeRetriesAfterFailedFetch(t *testing.T) {
+	pair := Pair{First: "BTC", Second: "USD"}
+	attempts := 0
+
+	withCexIoLimitsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"data": {"pairs": [
+			{"symbol1": "BTC", "symbol2": "USD", "minPrice": "0.01", "minLotSize": 0.0001}
+		]}}`))
+	})
+
+	cexIo := &CexIo{}
+
+	if tickSize := cexIo.tickSize(pair); tickSize != nil {
+		t.Fatalf("expected nil tick size after a failed fetch, got %v", tickSize)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected 1 fetch attempt, got %d", attempts)
+	}
+
+	tickSize := cexIo.tickSize(pair)
+	if tickSize == nil {
+		t.Fatal("expected a tick size after the retry succeeds")
+	}
+	if tickSize.PriceTickSize != 0.01 {
+		t.Errorf("expected price tick size 0.01, got %v", tickSize.PriceTickSize)
+	}
+	if attempts != 2 {
+		t.Fatalf("expected a retry fetch after the first one failed, got %d attempts", attempts)
+	}
+
+	// A third lookup should reuse the cached tick sizes instead of fetching again.
+	cexIo.tickSize(pair)
+	if attempts != 2 {
+		t.Fatalf("expected no further fetch once tick sizes are loaded, got %d attempts", attempts)
+	}
+}
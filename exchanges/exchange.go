@@ -0,0 +1,48 @@
+// Package exchanges provides a common interface over the various crypto and
+// stock exchanges CryptoPricesBot can quote prices from, so the bot's
+// controllers don't need to know how any particular venue's API works.
+package exchanges
+
+import "fmt"
+
+// Pair is a tradeable symbol pair, e.g. BTC/USD or NCBFG/JMD.
+type Pair struct {
+	First  string
+	Second string
+}
+
+func (pair Pair) String() string {
+	return fmt.Sprintf("%s/%s", pair.First, pair.Second)
+}
+
+// Quote is a single price observation for a Pair from a PriceSource.
+type Quote struct {
+	Pair      Pair
+	Price     float64
+	SourceURL string
+
+	// TickSize is the exchange's reported precision for Pair, if the
+	// PriceSource knows it. Callers that format a Quote should fall back to
+	// their own default precision when this is nil.
+	TickSize *TickSize
+}
+
+// PriceSource is implemented by anything that can quote a Pair's price.
+// Each exchange CryptoPricesBot supports gets its own PriceSource
+// implementation in this package.
+type PriceSource interface {
+	// Ticker looks up the current price for pair on the exchange.
+	Ticker(pair Pair) (*Quote, error)
+}
+
+// Registry maps an exchange's command-line name to the PriceSource that
+// serves it. Controllers look exchanges up here instead of hardcoding a
+// specific implementation, so a new exchange can be added without touching
+// controller code.
+var Registry = map[string]PriceSource{}
+
+// Register adds a PriceSource to the Registry under name. Exchange
+// implementations call this from an init function.
+func Register(name string, source PriceSource) {
+	Registry[name] = source
+}
@@ -0,0 +1,46 @@
+package exchanges
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Overridable in tests so they can point at an httptest.Server instead of
+// the real API.
+var huobiPriceAPIEndpoint = "https://api.huobi.pro/market/detail/merged?symbol=%s"
+
+func init() {
+	Register("huobi", &Huobi{})
+}
+
+// Huobi quotes prices from Huobi.
+type Huobi struct{}
+
+func (Huobi) Ticker(pair Pair) (*Quote, error) {
+	symbol := strings.ToLower(pair.First + pair.Second)
+	url := fmt.Sprintf(huobiPriceAPIEndpoint, symbol)
+	resp, err := http.Get(url)
+	if err != nil || resp.StatusCode != 200 {
+		return nil, fmt.Errorf("I can't reach https://huobi.pro right now.\nTry again later")
+	}
+	defer resp.Body.Close()
+
+	var tickerResponse struct {
+		Status string `json:"status"`
+		Tick   struct {
+			Close float64 `json:"close"`
+		} `json:"tick"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&tickerResponse)
+	if err != nil || tickerResponse.Status != "ok" {
+		return nil, fmt.Errorf("I can't find '%s' on https://huobi.pro", pair)
+	}
+
+	return &Quote{
+		Pair:      pair,
+		Price:     tickerResponse.Tick.Close,
+		SourceURL: "https://www.huobi.com/en-us/exchange/" + symbol,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package exchanges
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func withHuobiServer(t *testing.T, handler http.HandlerFunc) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	original := huobiPriceAPIEndpoint
+	huobiPriceAPIEndpoint = server.URL + "/%s"
+	t.Cleanup(func() { huobiPriceAPIEndpoint = original })
+}
+
+func TestHuobiTicker(t *testing.T) {
+	pair := Pair{First: "BTC", Second: "USDT"}
+
+	withHuobiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status": "ok", "tick": {"close": 50000.12}}`))
+	})
+
+	quote, err := (Huobi{}).Ticker(pair)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if quote.Price != 50000.12 {
+		t.Errorf("expected price 50000.12, got %v", quote.Price)
+	}
+}
+
+func TestHuobiTickerNon200(t *testing.T) {
+	pair := Pair{First: "BTC", Second: "USDT"}
+
+	withHuobiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := (Huobi{}).Ticker(pair)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestHuobiTickerMalformedJSON(t *testing.T) {
+	pair := Pair{First: "BTC", Second: "USDT"}
+
+	withHuobiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`not json`))
+	})
+
+	_, err := (Huobi{}).Ticker(pair)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestHuobiTickerPairNotFound(t *testing.T) {
+	pair := Pair{First: "DOESNOTEXIST", Second: "USDT"}
+
+	withHuobiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status": "error"}`))
+	})
+
+	_, err := (Huobi{}).Ticker(pair)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if !strings.Contains(err.Error(), pair.String()) {
+		t.Errorf("expected error to mention %s, got: %v", pair, err)
+	}
+}
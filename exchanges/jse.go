@@ -0,0 +1,55 @@
+package exchanges
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/joncooperworks/jsonjse"
+)
+
+// jseSourceURL is overridable in tests so they can point at an
+// httptest.Server instead of the real API.
+var jseSourceURL = "https://jsonjse.herokuapp.com/jse/today"
+
+// jseTickSize is static rather than fetched: the JSE quotes every stock to
+// the cent in whole-share lots, and jsonjse's today endpoint doesn't expose
+// per-symbol lot size.
+var jseTickSize = &TickSize{
+	PriceTickSize:  0.01,
+	AmountTickSize: 1,
+	QuoteCurrency:  "JMD",
+}
+
+func init() {
+	Register("jse", &Jse{})
+}
+
+// Jse quotes stock prices from the Jamaica Stock Exchange.
+type Jse struct{}
+
+func (Jse) Ticker(pair Pair) (*Quote, error) {
+	resp, err := http.Get(jseSourceURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var symbols []jsonjse.Symbol
+	err = json.NewDecoder(resp.Body).Decode(&symbols)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, symbol := range symbols {
+		if symbol.Symbol == pair.First {
+			return &Quote{
+				Pair:      pair,
+				Price:     symbol.LastTraded,
+				SourceURL: jseSourceURL,
+				TickSize:  jseTickSize,
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("Could not find %v on the JSE", pair.First)
+}
@@ -0,0 +1,77 @@
+package exchanges
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func withJseServer(t *testing.T, handler http.HandlerFunc) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	original := jseSourceURL
+	jseSourceURL = server.URL
+	t.Cleanup(func() { jseSourceURL = original })
+}
+
+func TestJseTicker(t *testing.T) {
+	pair := Pair{First: "NCBFG", Second: "JMD"}
+
+	withJseServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"symbol": "NCBFG", "last_traded": 123.45}]`))
+	})
+
+	quote, err := (Jse{}).Ticker(pair)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if quote.Price != 123.45 {
+		t.Errorf("expected price 123.45, got %v", quote.Price)
+	}
+}
+
+func TestJseTickerNon200(t *testing.T) {
+	pair := Pair{First: "NCBFG", Second: "JMD"}
+
+	withJseServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`not json`))
+	})
+
+	_, err := (Jse{}).Ticker(pair)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestJseTickerMalformedJSON(t *testing.T) {
+	pair := Pair{First: "NCBFG", Second: "JMD"}
+
+	withJseServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`not json`))
+	})
+
+	_, err := (Jse{}).Ticker(pair)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestJseTickerPairNotFound(t *testing.T) {
+	pair := Pair{First: "DOESNOTEXIST", Second: "JMD"}
+
+	withJseServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"symbol": "NCBFG", "last_traded": 123.45}]`))
+	})
+
+	_, err := (Jse{}).Ticker(pair)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if !strings.Contains(err.Error(), pair.First) {
+		t.Errorf("expected error to mention %s, got: %v", pair.First, err)
+	}
+}
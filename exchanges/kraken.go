@@ -0,0 +1,59 @@
+package exchanges
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// Overridable in tests so they can point at an httptest.Server instead of
+// the real API.
+var krakenPriceAPIEndpoint = "https://api.kraken.com/0/public/Ticker?pair=%s%s"
+
+func init() {
+	Register("kraken", &Kraken{})
+}
+
+// Kraken quotes prices from Kraken.
+type Kraken struct{}
+
+func (Kraken) Ticker(pair Pair) (*Quote, error) {
+	url := fmt.Sprintf(krakenPriceAPIEndpoint, pair.First, pair.Second)
+	resp, err := http.Get(url)
+	if err != nil || resp.StatusCode != 200 {
+		return nil, fmt.Errorf("I can't reach https://kraken.com right now.\nTry again later")
+	}
+	defer resp.Body.Close()
+
+	var tickerResponse struct {
+		Error  []string `json:"error"`
+		Result map[string]struct {
+			Close []string `json:"c"`
+		} `json:"result"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&tickerResponse)
+	if err != nil || len(tickerResponse.Error) > 0 || len(tickerResponse.Result) == 0 {
+		return nil, fmt.Errorf("I can't find '%s' on https://kraken.com", pair)
+	}
+
+	// Kraken's result is keyed by its own internal pair name (e.g. XXBTZUSD),
+	// which doesn't map cleanly back to the pair we asked for, so just take
+	// the only entry Kraken gave us.
+	for _, ticker := range tickerResponse.Result {
+		if len(ticker.Close) == 0 {
+			continue
+		}
+		price, err := strconv.ParseFloat(ticker.Close[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("I'm having trouble reading the response for '%s' from https://kraken.com", pair)
+		}
+		return &Quote{
+			Pair:      pair,
+			Price:     price,
+			SourceURL: "https://trade.kraken.com/charts",
+		}, nil
+	}
+
+	return nil, fmt.Errorf("I can't find '%s' on https://kraken.com", pair)
+}
@@ -0,0 +1,76 @@
+package exchanges
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func withKrakenServer(t *testing.T, handler http.HandlerFunc) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	original := krakenPriceAPIEndpoint
+	krakenPriceAPIEndpoint = server.URL + "/?pair=%s%s"
+	t.Cleanup(func() { krakenPriceAPIEndpoint = original })
+}
+
+func TestKrakenTicker(t *testing.T) {
+	pair := Pair{First: "XBT", Second: "USD"}
+
+	withKrakenServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"error": [], "result": {"XXBTZUSD": {"c": ["50000.12", "1.0"]}}}`))
+	})
+
+	quote, err := (Kraken{}).Ticker(pair)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if quote.Price != 50000.12 {
+		t.Errorf("expected price 50000.12, got %v", quote.Price)
+	}
+}
+
+func TestKrakenTickerNon200(t *testing.T) {
+	pair := Pair{First: "XBT", Second: "USD"}
+
+	withKrakenServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := (Kraken{}).Ticker(pair)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestKrakenTickerMalformedJSON(t *testing.T) {
+	pair := Pair{First: "XBT", Second: "USD"}
+
+	withKrakenServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`not json`))
+	})
+
+	_, err := (Kraken{}).Ticker(pair)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestKrakenTickerPairNotFound(t *testing.T) {
+	pair := Pair{First: "DOESNOTEXIST", Second: "USD"}
+
+	withKrakenServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"error": ["EQuery:Unknown asset pair"], "result": {}}`))
+	})
+
+	_, err := (Kraken{}).Ticker(pair)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if !strings.Contains(err.Error(), pair.String()) {
+		t.Errorf("expected error to mention %s, got: %v", pair, err)
+	}
+}
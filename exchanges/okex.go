@@ -0,0 +1,47 @@
+package exchanges
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// Overridable in tests so they can point at an httptest.Server instead of
+// the real API.
+var okexPriceAPIEndpoint = "https://www.okex.com/api/spot/v3/instruments/%s-%s/ticker"
+
+func init() {
+	Register("okex", &OKEx{})
+}
+
+// OKEx quotes prices from OKEx.
+type OKEx struct{}
+
+func (OKEx) Ticker(pair Pair) (*Quote, error) {
+	url := fmt.Sprintf(okexPriceAPIEndpoint, pair.First, pair.Second)
+	resp, err := http.Get(url)
+	if err != nil || resp.StatusCode != 200 {
+		return nil, fmt.Errorf("I can't reach https://okex.com right now.\nTry again later")
+	}
+	defer resp.Body.Close()
+
+	var tickerResponse struct {
+		Last string `json:"last"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&tickerResponse)
+	if err != nil || tickerResponse.Last == "" {
+		return nil, fmt.Errorf("I can't find '%s' on https://okex.com", pair)
+	}
+
+	price, err := strconv.ParseFloat(tickerResponse.Last, 64)
+	if err != nil {
+		return nil, fmt.Errorf("I'm having trouble reading the response for '%s' from https://okex.com", pair)
+	}
+
+	return &Quote{
+		Pair:      pair,
+		Price:     price,
+		SourceURL: "https://www.okex.com/trade-spot/" + pair.First + "-" + pair.Second,
+	}, nil
+}
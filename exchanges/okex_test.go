@@ -0,0 +1,76 @@
+package exchanges
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func withOkexServer(t *testing.T, handler http.HandlerFunc) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	original := okexPriceAPIEndpoint
+	okexPriceAPIEndpoint = server.URL + "/%s-%s"
+	t.Cleanup(func() { okexPriceAPIEndpoint = original })
+}
+
+func TestOKExTicker(t *testing.T) {
+	pair := Pair{First: "BTC", Second: "USDT"}
+
+	withOkexServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"last": "50000.12"}`))
+	})
+
+	quote, err := (OKEx{}).Ticker(pair)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if quote.Price != 50000.12 {
+		t.Errorf("expected price 50000.12, got %v", quote.Price)
+	}
+}
+
+func TestOKExTickerNon200(t *testing.T) {
+	pair := Pair{First: "BTC", Second: "USDT"}
+
+	withOkexServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := (OKEx{}).Ticker(pair)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestOKExTickerMalformedJSON(t *testing.T) {
+	pair := Pair{First: "BTC", Second: "USDT"}
+
+	withOkexServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`not json`))
+	})
+
+	_, err := (OKEx{}).Ticker(pair)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestOKExTickerPairNotFound(t *testing.T) {
+	pair := Pair{First: "DOESNOTEXIST", Second: "USDT"}
+
+	withOkexServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"last": ""}`))
+	})
+
+	_, err := (OKEx{}).Ticker(pair)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if !strings.Contains(err.Error(), pair.String()) {
+		t.Errorf("expected error to mention %s, got: %v", pair, err)
+	}
+}
@@ -0,0 +1,48 @@
+package exchanges
+
+import "time"
+
+// TickSize describes the precision an exchange reports for a pair: the
+// smallest price and amount increments it accepts. Quote formatting uses
+// this instead of guessing decimal places from the magnitude of a price.
+type TickSize struct {
+	PriceTickSize  float64
+	AmountTickSize float64
+	QuoteCurrency  string
+
+	// Futures is set for futures-style instruments so a future /futures
+	// command can show contract value and delivery date alongside price.
+	Futures *FuturesContractInfo
+}
+
+// FuturesContractInfo carries the extra metadata futures-style instruments
+// report beyond a plain spot price.
+type FuturesContractInfo struct {
+	ContractVal  float64
+	ContractType string
+	DeliveryDate time.Time
+}
+
+// PriceDecimals returns how many digits after the decimal point are needed
+// to represent PriceTickSize, e.g. a tick size of 0.01 needs 2 decimals and
+// 0.00000001 needs 8.
+func (tickSize *TickSize) PriceDecimals() int {
+	return decimalsFor(tickSize.PriceTickSize)
+}
+
+// AmountDecimals is PriceDecimals for AmountTickSize.
+func (tickSize *TickSize) AmountDecimals() int {
+	return decimalsFor(tickSize.AmountTickSize)
+}
+
+func decimalsFor(tick float64) int {
+	if tick <= 0 {
+		return 8
+	}
+	decimals := 0
+	for tick < 1 && decimals < 8 {
+		tick *= 10
+		decimals++
+	}
+	return decimals
+}
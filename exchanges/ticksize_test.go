@@ -0,0 +1,32 @@
+package exchanges
+
+import "testing"
+
+func TestDecimalsFor(t *testing.T) {
+	cases := []struct {
+		tick     float64
+		decimals int
+	}{
+		{0, 8},
+		{0.01, 2},
+		{0.00000001, 8},
+		{1, 0},
+	}
+
+	for _, c := range cases {
+		if got := decimalsFor(c.tick); got != c.decimals {
+			t.Errorf("decimalsFor(%v) = %d, want %d", c.tick, got, c.decimals)
+		}
+	}
+}
+
+func TestTickSizePriceAndAmountDecimals(t *testing.T) {
+	tickSize := &TickSize{PriceTickSize: 0.01, AmountTickSize: 0.00000001}
+
+	if got := tickSize.PriceDecimals(); got != 2 {
+		t.Errorf("PriceDecimals() = %d, want 2", got)
+	}
+	if got := tickSize.AmountDecimals(); got != 8 {
+		t.Errorf("AmountDecimals() = %d, want 8", got)
+	}
+}
@@ -0,0 +1,63 @@
+package router
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// LoggingMiddleware logs every request before it reaches its Handler.
+func LoggingMiddleware() Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req Request) Response {
+			log.Printf("[%s - %s] %s %v", req.UserName, req.FirstName, req.Command, req.Arguments)
+			return next(ctx, req)
+		}
+	}
+}
+
+// RateLimitMiddleware rejects a chat's requests that arrive more often than
+// once per interval, replying with a friendly message instead of calling
+// next.
+func RateLimitMiddleware(interval time.Duration) Middleware {
+	var mu sync.Mutex
+	last := map[int64]time.Time{}
+
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req Request) Response {
+			mu.Lock()
+			previous, seen := last[req.ChatID]
+			now := time.Now()
+			allowed := !seen || now.Sub(previous) >= interval
+			if allowed {
+				last[req.ChatID] = now
+			}
+			mu.Unlock()
+
+			if !allowed {
+				return Response{Text: "You're sending commands too quickly, slow down a bit."}
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// ChatActionSender notifies a chat that the bot is working on a reply.
+// The bot package implements this over tgbotapi so this package doesn't
+// need to know about Telegram.
+type ChatActionSender interface {
+	SendChatAction(chatID int64) error
+}
+
+// TypingMiddleware tells the chat the bot is typing while a Handler runs.
+func TypingMiddleware(sender ChatActionSender) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req Request) Response {
+			if err := sender.SendChatAction(req.ChatID); err != nil {
+				log.Println("Could not send typing indicator:", err)
+			}
+			return next(ctx, req)
+		}
+	}
+}
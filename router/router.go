@@ -0,0 +1,187 @@
+// Package router dispatches parsed bot commands to registered Handlers,
+// independent of any particular chat transport. Handlers take a Request and
+// return a Response, so they can be unit tested without constructing a full
+// tgbotapi.Update.
+package router
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Response is what a Handler returns for the transport layer to render back
+// to the user. A Response with a non-nil Photo is rendered as an image
+// (e.g. a PNG chart) instead of Text.
+type Response struct {
+	Text  string
+	Photo []byte
+}
+
+// Request is a parsed, validated command invocation.
+type Request struct {
+	ChatID    int64
+	UserName  string
+	FirstName string
+	Command   string
+	Arguments []string
+}
+
+// Handler processes a Request and returns the Response to send back.
+type Handler func(ctx context.Context, req Request) Response
+
+// Middleware wraps a Handler to add cross-cutting behaviour like logging,
+// rate limiting or typing indicators. Middleware run outermost-first, in
+// the order they're passed to Use.
+type Middleware func(Handler) Handler
+
+// ArgKind describes what a positional argument is expected to hold, so the
+// Router can validate it before a Handler ever sees it.
+type ArgKind int
+
+const (
+	// ArgToken accepts anything; only presence is checked.
+	ArgToken ArgKind = iota
+	// ArgSymbol is a ticker symbol. Handlers normalize case themselves.
+	ArgSymbol
+	// ArgCurrency is a currency or exchange code. Handlers normalize case
+	// themselves.
+	ArgCurrency
+	// ArgAmount must parse as a float64.
+	ArgAmount
+	// ArgID must parse as an unsigned integer.
+	ArgID
+)
+
+// ArgSpec describes one positional argument a command accepts.
+type ArgSpec struct {
+	Name     string
+	Kind     ArgKind
+	Optional bool
+}
+
+type route struct {
+	handler Handler
+	args    []ArgSpec
+}
+
+// Router dispatches parsed commands to registered Handlers.
+type Router struct {
+	routes     map[string]route
+	fallback   Handler
+	unknown    Handler
+	middleware []Middleware
+}
+
+// New builds an empty Router.
+func New() *Router {
+	return &Router{routes: map[string]route{}}
+}
+
+// Use appends mw to the middleware chain.
+func (router *Router) Use(mw Middleware) {
+	router.middleware = append(router.middleware, mw)
+}
+
+// Handle registers handler for command. Arguments are validated against
+// args, in order, before handler runs; a validation failure short-circuits
+// straight to a Response describing the problem.
+func (router *Router) Handle(command string, handler Handler, args ...ArgSpec) {
+	router.routes[command] = route{handler: handler, args: args}
+}
+
+// HandleDefault registers the Handler used for messages that aren't slash
+// commands at all, e.g. a bare stock symbol.
+func (router *Router) HandleDefault(handler Handler) {
+	router.fallback = handler
+}
+
+// HandleUnknown registers the Handler used when a message is a slash
+// command Router doesn't recognize.
+func (router *Router) HandleUnknown(handler Handler) {
+	router.unknown = handler
+}
+
+// Route parses text as either "/command args..." (isCommand) or a bare
+// fallback invocation, runs it through the middleware chain, and returns
+// the Response to send back. ok is false when nothing should be sent, e.g.
+// an empty message or a fallback invocation with no fallback registered.
+func (router *Router) Route(ctx context.Context, chatID int64, userName, firstName string, isCommand bool, text string) (response Response, ok bool) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return Response{}, false
+	}
+
+	var command string
+	var matched route
+	var arguments []string
+
+	if isCommand {
+		command = strings.SplitN(strings.ToLower(fields[0]), "@", 2)[0]
+		matchedRoute, found := router.routes[command]
+		if !found {
+			if router.unknown == nil {
+				return Response{}, false
+			}
+			return router.dispatch(ctx, router.unknown, Request{ChatID: chatID, UserName: userName, FirstName: firstName, Command: command}), true
+		}
+		matched = matchedRoute
+		arguments = fields[1:]
+	} else {
+		if router.fallback == nil || len(fields) > 3 {
+			return Response{}, false
+		}
+		matched = route{handler: router.fallback}
+		arguments = fields
+	}
+
+	validated, err := validateArgs(matched.args, arguments)
+	if err != nil {
+		return Response{Text: err.Error()}, true
+	}
+
+	req := Request{
+		ChatID:    chatID,
+		UserName:  userName,
+		FirstName: firstName,
+		Command:   command,
+		Arguments: validated,
+	}
+	return router.dispatch(ctx, matched.handler, req), true
+}
+
+func (router *Router) dispatch(ctx context.Context, handler Handler, req Request) Response {
+	for i := len(router.middleware) - 1; i >= 0; i-- {
+		handler = router.middleware[i](handler)
+	}
+	return handler(ctx, req)
+}
+
+func validateArgs(specs []ArgSpec, arguments []string) ([]string, error) {
+	if len(specs) == 0 {
+		return arguments, nil
+	}
+
+	for i, spec := range specs {
+		if i >= len(arguments) {
+			if spec.Optional {
+				continue
+			}
+			return nil, fmt.Errorf("missing required argument '%s'", spec.Name)
+		}
+
+		if spec.Kind == ArgAmount {
+			if _, err := strconv.ParseFloat(arguments[i], 64); err != nil {
+				return nil, fmt.Errorf("'%s' isn't a valid %s", arguments[i], spec.Name)
+			}
+		}
+
+		if spec.Kind == ArgID {
+			if _, err := strconv.ParseUint(arguments[i], 10, 64); err != nil {
+				return nil, fmt.Errorf("'%s' isn't a valid %s", arguments[i], spec.Name)
+			}
+		}
+	}
+	return arguments, nil
+}
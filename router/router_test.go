@@ -0,0 +1,171 @@
+package router
+
+import (
+	"context"
+	"testing"
+)
+
+func echoHandler(ctx context.Context, req Request) Response {
+	return Response{Text: req.Command}
+}
+
+func TestRouteDispatchesToRegisteredCommand(t *testing.T) {
+	r := New()
+	r.Handle("/start", echoHandler)
+
+	response, ok := r.Route(context.Background(), 1, "user", "First", true, "/start")
+	if !ok {
+		t.Fatal("expected a response")
+	}
+	if response.Text != "/start" {
+		t.Errorf("expected handler to run, got %q", response.Text)
+	}
+}
+
+func TestRouteStripsBotNameSuffix(t *testing.T) {
+	r := New()
+	r.Handle("/start", echoHandler)
+
+	response, ok := r.Route(context.Background(), 1, "user", "First", true, "/start@SomeBot")
+	if !ok {
+		t.Fatal("expected a response")
+	}
+	if response.Text != "/start" {
+		t.Errorf("expected @botname to be stripped, got %q", response.Text)
+	}
+}
+
+func TestRouteUnknownCommandUsesUnknownHandler(t *testing.T) {
+	r := New()
+	r.HandleUnknown(func(ctx context.Context, req Request) Response {
+		return Response{Text: "unknown"}
+	})
+
+	response, ok := r.Route(context.Background(), 1, "user", "First", true, "/nope")
+	if !ok {
+		t.Fatal("expected a response")
+	}
+	if response.Text != "unknown" {
+		t.Errorf("expected unknown handler to run, got %q", response.Text)
+	}
+}
+
+func TestRouteUnknownCommandWithNoHandlerSendsNothing(t *testing.T) {
+	r := New()
+
+	_, ok := r.Route(context.Background(), 1, "user", "First", true, "/nope")
+	if ok {
+		t.Fatal("expected no response")
+	}
+}
+
+func TestRouteMissingRequiredArgument(t *testing.T) {
+	r := New()
+	r.Handle("/foo", echoHandler, ArgSpec{Name: "symbol", Kind: ArgToken})
+
+	response, ok := r.Route(context.Background(), 1, "user", "First", true, "/foo")
+	if !ok {
+		t.Fatal("expected a response")
+	}
+	if response.Text == "/foo" {
+		t.Error("expected a validation error, not the handler's response")
+	}
+}
+
+func TestRouteArgAmountRejectsNonNumeric(t *testing.T) {
+	r := New()
+	r.Handle("/foo", echoHandler, ArgSpec{Name: "price", Kind: ArgAmount})
+
+	response, ok := r.Route(context.Background(), 1, "user", "First", true, "/foo notanumber")
+	if !ok {
+		t.Fatal("expected a response")
+	}
+	if response.Text == "/foo" {
+		t.Error("expected a validation error, not the handler's response")
+	}
+}
+
+func TestRouteArgIDRejectsNegativeAndFractional(t *testing.T) {
+	r := New()
+	r.Handle("/foo", echoHandler, ArgSpec{Name: "id", Kind: ArgID})
+
+	for _, arg := range []string{"-1", "3.5", "notanumber"} {
+		response, ok := r.Route(context.Background(), 1, "user", "First", true, "/foo "+arg)
+		if !ok {
+			t.Fatal("expected a response")
+		}
+		if response.Text == "/foo" {
+			t.Errorf("expected %q to fail ArgID validation", arg)
+		}
+	}
+}
+
+func TestRouteArgIDAcceptsUnsignedInteger(t *testing.T) {
+	r := New()
+	r.Handle("/foo", echoHandler, ArgSpec{Name: "id", Kind: ArgID})
+
+	response, ok := r.Route(context.Background(), 1, "user", "First", true, "/foo 42")
+	if !ok {
+		t.Fatal("expected a response")
+	}
+	if response.Text != "/foo" {
+		t.Errorf("expected handler to run, got %q", response.Text)
+	}
+}
+
+func TestRouteOptionalArgumentMayBeOmitted(t *testing.T) {
+	r := New()
+	r.Handle("/foo", echoHandler, ArgSpec{Name: "symbol", Kind: ArgToken}, ArgSpec{Name: "currency", Kind: ArgCurrency, Optional: true})
+
+	response, ok := r.Route(context.Background(), 1, "user", "First", true, "/foo BTC")
+	if !ok {
+		t.Fatal("expected a response")
+	}
+	if response.Text != "/foo" {
+		t.Errorf("expected handler to run, got %q", response.Text)
+	}
+}
+
+func TestRouteFallbackHandlesBareText(t *testing.T) {
+	r := New()
+	r.HandleDefault(echoHandler)
+
+	response, ok := r.Route(context.Background(), 1, "user", "First", false, "NCBFG")
+	if !ok {
+		t.Fatal("expected a response")
+	}
+	if response.Text != "" {
+		t.Errorf("expected fallback command to be empty, got %q", response.Text)
+	}
+}
+
+func TestRouteMiddlewareRunsAroundHandler(t *testing.T) {
+	r := New()
+	var order []string
+	r.Use(func(next Handler) Handler {
+		return func(ctx context.Context, req Request) Response {
+			order = append(order, "before")
+			response := next(ctx, req)
+			order = append(order, "after")
+			return response
+		}
+	})
+	r.Handle("/foo", func(ctx context.Context, req Request) Response {
+		order = append(order, "handler")
+		return Response{}
+	})
+
+	if _, ok := r.Route(context.Background(), 1, "user", "First", true, "/foo"); !ok {
+		t.Fatal("expected a response")
+	}
+
+	expected := []string{"before", "handler", "after"}
+	if len(order) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, order)
+	}
+	for i := range expected {
+		if order[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected, order)
+		}
+	}
+}